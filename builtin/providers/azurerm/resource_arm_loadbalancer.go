@@ -0,0 +1,289 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/arm/network"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmLoadBalancer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLoadBalancerCreate,
+		Read:   resourceArmLoadBalancerRead,
+		Update: resourceArmLoadBalancerCreate,
+		Delete: resourceArmLoadBalancerDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: azureRMNormalizeLocation,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"sku": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      string(network.LoadBalancerSkuNameBasic),
+				ValidateFunc: validateArmLoadBalancerSku,
+			},
+
+			"frontend_ip_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"private_ip_address": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"private_ip_address_allocation": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"public_ip_address_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmLoadBalancerCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	lbClient := client.loadBalancerClient
+
+	name := d.Get("name").(string)
+	location := d.Get("location").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	properties := network.LoadBalancerPropertiesFormat{
+		FrontendIPConfigurations: expandAzureRmLoadBalancerFrontendIpConfigurations(d),
+	}
+
+	loadBalancer := network.LoadBalancer{
+		Name:       azure.String(name),
+		Location:   azure.String(location),
+		Properties: &properties,
+		Sku: &network.LoadBalancerSku{
+			Name: network.LoadBalancerSkuName(d.Get("sku").(string)),
+		},
+	}
+
+	_, err := lbClient.CreateOrUpdate(resGroup, name, loadBalancer, make(chan struct{}))
+	if err != nil {
+		return errwrap.Wrapf("Error Creating/Updating LoadBalancer {{err}}", err)
+	}
+
+	read, err := lbClient.Get(resGroup, name, "")
+	if err != nil {
+		return errwrap.Wrapf("Error Getting LoadBalancer {{err}}", err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read LoadBalancer %s (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	log.Printf("[DEBUG] Waiting for LoadBalancer (%s) to become available", name)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Accepted", "Updating"},
+		Target:  []string{"Succeeded"},
+		Refresh: loadbalancerStateRefreshFunc(client, resGroup, name),
+		Timeout: 10 * time.Minute,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for LoadBalancer (%s) to become available: %s", name, err)
+	}
+
+	return resourceArmLoadBalancerRead(d, meta)
+}
+
+func resourceArmLoadBalancerRead(d *schema.ResourceData, meta interface{}) error {
+	loadBalancer, exists, err := retrieveLoadBalancerById(d.Id(), meta)
+	if err != nil {
+		return errwrap.Wrapf("Error Getting LoadBalancer By ID {{err}}", err)
+	}
+	if !exists {
+		d.SetId("")
+		log.Printf("[INFO] LoadBalancer %q not found. Removing from state", d.Id())
+		return nil
+	}
+
+	d.Set("name", loadBalancer.Name)
+	d.Set("location", azureRMNormalizeLocation(*loadBalancer.Location))
+
+	if loadBalancer.Sku != nil {
+		d.Set("sku", string(loadBalancer.Sku.Name))
+	}
+
+	return nil
+}
+
+func resourceArmLoadBalancerDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	lbClient := client.loadBalancerClient
+
+	resGroup, name, err := resourceGroupAndLBNameFromId(d.Id())
+	if err != nil {
+		return errwrap.Wrapf("Error Getting LoadBalancer Name and Group: {{err}}", err)
+	}
+
+	_, err = lbClient.Delete(resGroup, name, make(chan struct{}))
+	if err != nil {
+		return errwrap.Wrapf("Error Deleting LoadBalancer {{err}}", err)
+	}
+
+	return nil
+}
+
+func expandAzureRmLoadBalancerFrontendIpConfigurations(d *schema.ResourceData) *[]network.FrontendIPConfiguration {
+	configs := d.Get("frontend_ip_configuration").([]interface{})
+	feConfigs := make([]network.FrontendIPConfiguration, 0, len(configs))
+
+	for _, configRaw := range configs {
+		data := configRaw.(map[string]interface{})
+
+		properties := network.FrontendIPConfigurationPropertiesFormat{}
+
+		if v := data["private_ip_address"].(string); v != "" {
+			properties.PrivateIPAddress = azure.String(v)
+		}
+
+		if v := data["private_ip_address_allocation"].(string); v != "" {
+			properties.PrivateIPAllocationMethod = network.IPAllocationMethod(v)
+		}
+
+		if v := data["subnet_id"].(string); v != "" {
+			properties.Subnet = &network.Subnet{ID: azure.String(v)}
+		}
+
+		if v := data["public_ip_address_id"].(string); v != "" {
+			properties.PublicIPAddress = &network.PublicIPAddress{ID: azure.String(v)}
+		}
+
+		feConfigs = append(feConfigs, network.FrontendIPConfiguration{
+			Name:       azure.String(data["name"].(string)),
+			Properties: &properties,
+		})
+	}
+
+	return &feConfigs
+}
+
+// retrieveLoadBalancerById looks up a Load Balancer by its full Azure
+// resource ID, returning false if it no longer exists.
+func retrieveLoadBalancerById(lbId string, meta interface{}) (*network.LoadBalancer, bool, error) {
+	client := meta.(*ArmClient)
+	lbClient := client.loadBalancerClient
+
+	resGroup, name, err := resourceGroupAndLBNameFromId(lbId)
+	if err != nil {
+		return nil, false, errwrap.Wrapf("Error Getting LoadBalancer Name and Group: {{err}}", err)
+	}
+
+	resp, err := lbClient.Get(resGroup, name, "")
+	if err != nil {
+		if resp.StatusCode == 404 {
+			return nil, false, nil
+		}
+		return nil, false, errwrap.Wrapf("Error making Read request on Load Balancer: {{err}}", err)
+	}
+
+	return &resp, true, nil
+}
+
+// resourceGroupAndLBNameFromId splits a Load Balancer's Azure resource ID
+// into its resource group and Load Balancer name.
+func resourceGroupAndLBNameFromId(loadBalancerId string) (string, string, error) {
+	id, err := parseAzureResourceID(loadBalancerId)
+	if err != nil {
+		return "", "", err
+	}
+	name := id.Path["loadBalancers"]
+	resGroup := id.ResourceGroup
+
+	return resGroup, name, nil
+}
+
+// loadbalancerStateRefreshFunc polls a Load Balancer's provisioning state
+// until it settles, for use with resource.StateChangeConf.
+func loadbalancerStateRefreshFunc(client *ArmClient, resourceGroupName string, loadBalancerName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res, err := client.loadBalancerClient.Get(resourceGroupName, loadBalancerName, "")
+		if err != nil {
+			return nil, "", fmt.Errorf("Error issuing read request in loadbalancerStateRefreshFunc to Azure ARM for LoadBalancer '%s' (RG: '%s'): %s", loadBalancerName, resourceGroupName, err)
+		}
+
+		return res, *res.Properties.ProvisioningState, nil
+	}
+}
+
+// findLoadBalancerFrontEndIpConfigurationByName locates a frontend IP
+// configuration on the given Load Balancer by name.
+func findLoadBalancerFrontEndIpConfigurationByName(lb *network.LoadBalancer, name string) (*network.FrontendIPConfiguration, int, bool) {
+	if lb == nil || lb.Properties == nil || lb.Properties.FrontendIPConfigurations == nil {
+		return nil, -1, false
+	}
+
+	for i, feip := range *lb.Properties.FrontendIPConfigurations {
+		if feip.Name != nil && strings.EqualFold(*feip.Name, name) {
+			return &feip, i, true
+		}
+	}
+
+	return nil, -1, false
+}
+
+func validateArmLoadBalancerSku(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	skus := map[string]bool{
+		string(network.LoadBalancerSkuNameBasic):    true,
+		string(network.LoadBalancerSkuNameStandard): true,
+	}
+
+	if !skus[value] {
+		errors = append(errors, fmt.Errorf("LoadBalancer Sku can only be Basic or Standard"))
+	}
+	return
+}
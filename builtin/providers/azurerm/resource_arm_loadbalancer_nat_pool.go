@@ -0,0 +1,263 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/arm/network"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmLoadBalancerNatPool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLoadBalancerNatPoolCreate,
+		Read:   resourceArmLoadBalancerNatPoolRead,
+		Update: resourceArmLoadBalancerNatPoolCreate,
+		Delete: resourceArmLoadBalancerNatPoolDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: azureRMNormalizeLocation,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"loadbalancer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"frontend_ip_configuration_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"frontend_ip_configuration_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"frontend_port_start": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"frontend_port_end": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"backend_port": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceArmLoadBalancerNatPoolCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	lbClient := client.loadBalancerClient
+
+	loadBalancer, exists, err := retrieveLoadBalancerById(d.Get("loadbalancer_id").(string), meta)
+	if err != nil {
+		return errwrap.Wrapf("Error Getting LoadBalancer By ID {{err}}", err)
+	}
+	if !exists {
+		d.SetId("")
+		log.Printf("[INFO] LoadBalancer %q not found. Removing from state", d.Get("name").(string))
+		return nil
+	}
+
+	_, index, exists := findLoadBalancerNatPoolByName(loadBalancer, d.Get("name").(string))
+	if exists && d.Id() == "" {
+		return fmt.Errorf("A LoadBalancer NAT Pool with name %q already exists.", d.Get("name").(string))
+	}
+
+	newNatPool, err := expandAzureRmLoadBalancerNatPool(d, loadBalancer)
+	if err != nil {
+		return errwrap.Wrapf("Error Exanding LoadBalancer NAT Pool {{err}}", err)
+	}
+
+	natPools := *loadBalancer.Properties.InboundNatPools
+	if exists {
+		natPools[index] = *newNatPool
+	} else {
+		natPools = append(natPools, *newNatPool)
+	}
+	loadBalancer.Properties.InboundNatPools = &natPools
+	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(d.Get("loadbalancer_id").(string))
+	if err != nil {
+		return errwrap.Wrapf("Error Getting LoadBalancer Name and Group: {{err}}", err)
+	}
+
+	_, err = lbClient.CreateOrUpdate(resGroup, loadBalancerName, *loadBalancer, make(chan struct{}))
+	if err != nil {
+		return errwrap.Wrapf("Error Creating/Updating LoadBalancer {{err}}", err)
+	}
+
+	read, err := lbClient.Get(resGroup, loadBalancerName, "")
+	if err != nil {
+		return errwrap.Wrapf("Error Getting LoadBalancer {{err}}", err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read LoadBalancer %s (resource group %s) ID", loadBalancerName, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	log.Printf("[DEBUG] Waiting for LoadBalancer (%s) to become available", loadBalancerName)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Accepted", "Updating"},
+		Target:  []string{"Succeeded"},
+		Refresh: loadbalancerStateRefreshFunc(client, resGroup, loadBalancerName),
+		Timeout: 10 * time.Minute,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for LoadBalancer (%s) to become available: %s", loadBalancerName, err)
+	}
+
+	return resourceArmLoadBalancerNatPoolRead(d, meta)
+}
+
+func resourceArmLoadBalancerNatPoolRead(d *schema.ResourceData, meta interface{}) error {
+	loadBalancer, exists, err := retrieveLoadBalancerById(d.Id(), meta)
+	if err != nil {
+		return errwrap.Wrapf("Error Getting LoadBalancer By ID {{err}}", err)
+	}
+	if !exists {
+		d.SetId("")
+		log.Printf("[INFO] LoadBalancer %q not found. Removing from state", d.Get("name").(string))
+		return nil
+	}
+
+	configs := *loadBalancer.Properties.InboundNatPools
+	for _, config := range configs {
+		if *config.Name == d.Get("name").(string) {
+			d.Set("name", config.Name)
+
+			d.Set("protocol", config.Properties.Protocol)
+			d.Set("frontend_port_start", config.Properties.FrontendPortRangeStart)
+			d.Set("frontend_port_end", config.Properties.FrontendPortRangeEnd)
+			d.Set("backend_port", config.Properties.BackendPort)
+
+			if config.Properties.FrontendIPConfiguration != nil {
+				d.Set("frontend_ip_configuration_id", config.Properties.FrontendIPConfiguration.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceArmLoadBalancerNatPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	lbClient := client.loadBalancerClient
+
+	loadBalancer, exists, err := retrieveLoadBalancerById(d.Get("loadbalancer_id").(string), meta)
+	if err != nil {
+		return errwrap.Wrapf("Error Getting LoadBalancer By ID {{err}}", err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	_, index, exists := findLoadBalancerNatPoolByName(loadBalancer, d.Get("name").(string))
+	if !exists {
+		return nil
+	}
+
+	oldNatPools := *loadBalancer.Properties.InboundNatPools
+	newNatPools := append(oldNatPools[:index], oldNatPools[index+1:]...)
+	loadBalancer.Properties.InboundNatPools = &newNatPools
+
+	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(d.Get("loadbalancer_id").(string))
+	if err != nil {
+		return errwrap.Wrapf("Error Getting LoadBalancer Name and Group: {{err}}", err)
+	}
+
+	_, err = lbClient.CreateOrUpdate(resGroup, loadBalancerName, *loadBalancer, make(chan struct{}))
+	if err != nil {
+		return errwrap.Wrapf("Error Creating/Updating LoadBalancer {{err}}", err)
+	}
+
+	read, err := lbClient.Get(resGroup, loadBalancerName, "")
+	if err != nil {
+		return errwrap.Wrapf("Error Getting LoadBalancer {{err}}", err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read LoadBalancer %s (resource group %s) ID", loadBalancerName, resGroup)
+	}
+
+	return nil
+}
+
+func expandAzureRmLoadBalancerNatPool(d *schema.ResourceData, lb *network.LoadBalancer) (*network.InboundNatPool, error) {
+	properties := network.InboundNatPoolPropertiesFormat{
+		Protocol:               network.TransportProtocol(d.Get("protocol").(string)),
+		FrontendPortRangeStart: azure.Int32(int32(d.Get("frontend_port_start").(int))),
+		FrontendPortRangeEnd:   azure.Int32(int32(d.Get("frontend_port_end").(int))),
+		BackendPort:            azure.Int32(int32(d.Get("backend_port").(int))),
+	}
+
+	if v := d.Get("frontend_ip_configuration_name").(string); v != "" {
+		rule, _, exists := findLoadBalancerFrontEndIpConfigurationByName(lb, v)
+		if !exists {
+			return nil, fmt.Errorf("[ERROR] Cannot find FrontEnd IP Configuration with the name %s", v)
+		}
+
+		feip := network.SubResource{
+			ID: rule.ID,
+		}
+
+		properties.FrontendIPConfiguration = &feip
+	}
+
+	natPool := network.InboundNatPool{
+		Name:       azure.String(d.Get("name").(string)),
+		Properties: &properties,
+	}
+
+	return &natPool, nil
+}
+
+// findLoadBalancerNatPoolByName locates an InboundNatPool on the given
+// Load Balancer by name, returning its index alongside it so callers can
+// splice it out of the backing slice.
+func findLoadBalancerNatPoolByName(lb *network.LoadBalancer, name string) (*network.InboundNatPool, int, bool) {
+	if lb == nil || lb.Properties == nil || lb.Properties.InboundNatPools == nil {
+		return nil, -1, false
+	}
+
+	for i, pool := range *lb.Properties.InboundNatPools {
+		if pool.Name != nil && *pool.Name == name {
+			return &pool, i, true
+		}
+	}
+
+	return nil, -1, false
+}
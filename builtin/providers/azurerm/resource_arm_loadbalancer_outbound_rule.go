@@ -0,0 +1,282 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/arm/network"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/jen20/riviera/azure"
+)
+
+func resourceArmLoadBalancerOutboundRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLoadBalancerOutboundRuleCreate,
+		Read:   resourceArmLoadBalancerOutboundRuleRead,
+		Update: resourceArmLoadBalancerOutboundRuleCreate,
+		Delete: resourceArmLoadBalancerOutboundRuleDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"loadbalancer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"backend_address_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"frontend_ip_configuration_names": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"frontend_ip_configuration_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"allocated_outbound_ports": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"idle_timeout_in_minutes": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmLoadBalancerOutboundRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	lbClient := client.loadBalancerClient
+
+	loadBalancer, exists, err := retrieveLoadBalancerById(d.Get("loadbalancer_id").(string), meta)
+	if err != nil {
+		return errwrap.Wrapf("Error Getting LoadBalancer By ID {{err}}", err)
+	}
+	if !exists {
+		d.SetId("")
+		log.Printf("[INFO] LoadBalancer %q not found. Removing from state", d.Get("name").(string))
+		return nil
+	}
+
+	if loadBalancerSkuName(loadBalancer) != network.LoadBalancerSkuNameStandard {
+		return fmt.Errorf("Outbound Rules are only supported on Standard SKU Load Balancers")
+	}
+
+	_, index, exists := findLoadBalancerOutboundRuleByName(loadBalancer, d.Get("name").(string))
+	if exists && d.Id() == "" {
+		return fmt.Errorf("A LoadBalancer Outbound Rule with name %q already exists.", d.Get("name").(string))
+	}
+
+	newOutboundRule, err := expandAzureRmLoadBalancerOutboundRule(d, loadBalancer)
+	if err != nil {
+		return errwrap.Wrapf("Error Exanding LoadBalancer Outbound Rule {{err}}", err)
+	}
+
+	outboundRules := *loadBalancer.Properties.OutboundRules
+	if exists {
+		outboundRules[index] = *newOutboundRule
+	} else {
+		outboundRules = append(outboundRules, *newOutboundRule)
+	}
+	loadBalancer.Properties.OutboundRules = &outboundRules
+	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(d.Get("loadbalancer_id").(string))
+	if err != nil {
+		return errwrap.Wrapf("Error Getting LoadBalancer Name and Group: {{err}}", err)
+	}
+
+	_, err = lbClient.CreateOrUpdate(resGroup, loadBalancerName, *loadBalancer, make(chan struct{}))
+	if err != nil {
+		return errwrap.Wrapf("Error Creating/Updating LoadBalancer {{err}}", err)
+	}
+
+	read, err := lbClient.Get(resGroup, loadBalancerName, "")
+	if err != nil {
+		return errwrap.Wrapf("Error Getting LoadBalancer {{err}}", err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read LoadBalancer %s (resource group %s) ID", loadBalancerName, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	log.Printf("[DEBUG] Waiting for LoadBalancer (%s) to become available", loadBalancerName)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Accepted", "Updating"},
+		Target:  []string{"Succeeded"},
+		Refresh: loadbalancerStateRefreshFunc(client, resGroup, loadBalancerName),
+		Timeout: 10 * time.Minute,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for LoadBalancer (%s) to become available: %s", loadBalancerName, err)
+	}
+
+	return resourceArmLoadBalancerOutboundRuleRead(d, meta)
+}
+
+func resourceArmLoadBalancerOutboundRuleRead(d *schema.ResourceData, meta interface{}) error {
+	loadBalancer, exists, err := retrieveLoadBalancerById(d.Id(), meta)
+	if err != nil {
+		return errwrap.Wrapf("Error Getting LoadBalancer By ID {{err}}", err)
+	}
+	if !exists {
+		d.SetId("")
+		log.Printf("[INFO] LoadBalancer %q not found. Removing from state", d.Get("name").(string))
+		return nil
+	}
+
+	configs := *loadBalancer.Properties.OutboundRules
+	for _, config := range configs {
+		if *config.Name == d.Get("name").(string) {
+			d.Set("name", config.Name)
+
+			d.Set("protocol", config.Properties.Protocol)
+			d.Set("allocated_outbound_ports", config.Properties.AllocatedOutboundPorts)
+			d.Set("idle_timeout_in_minutes", config.Properties.IdleTimeoutInMinutes)
+
+			if config.Properties.BackendAddressPool != nil {
+				d.Set("backend_address_pool_id", config.Properties.BackendAddressPool.ID)
+			}
+
+			if config.Properties.FrontendIPConfigurations != nil {
+				ids := make([]string, 0, len(*config.Properties.FrontendIPConfigurations))
+				for _, feip := range *config.Properties.FrontendIPConfigurations {
+					if feip.ID != nil {
+						ids = append(ids, *feip.ID)
+					}
+				}
+				d.Set("frontend_ip_configuration_ids", ids)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceArmLoadBalancerOutboundRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	lbClient := client.loadBalancerClient
+
+	loadBalancer, exists, err := retrieveLoadBalancerById(d.Get("loadbalancer_id").(string), meta)
+	if err != nil {
+		return errwrap.Wrapf("Error Getting LoadBalancer By ID {{err}}", err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	_, index, exists := findLoadBalancerOutboundRuleByName(loadBalancer, d.Get("name").(string))
+	if !exists {
+		return nil
+	}
+
+	oldOutboundRules := *loadBalancer.Properties.OutboundRules
+	newOutboundRules := append(oldOutboundRules[:index], oldOutboundRules[index+1:]...)
+	loadBalancer.Properties.OutboundRules = &newOutboundRules
+
+	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(d.Get("loadbalancer_id").(string))
+	if err != nil {
+		return errwrap.Wrapf("Error Getting LoadBalancer Name and Group: {{err}}", err)
+	}
+
+	_, err = lbClient.CreateOrUpdate(resGroup, loadBalancerName, *loadBalancer, make(chan struct{}))
+	if err != nil {
+		return errwrap.Wrapf("Error Creating/Updating LoadBalancer {{err}}", err)
+	}
+
+	read, err := lbClient.Get(resGroup, loadBalancerName, "")
+	if err != nil {
+		return errwrap.Wrapf("Error Getting LoadBalancer {{err}}", err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read LoadBalancer %s (resource group %s) ID", loadBalancerName, resGroup)
+	}
+
+	return nil
+}
+
+func expandAzureRmLoadBalancerOutboundRule(d *schema.ResourceData, lb *network.LoadBalancer) (*network.OutboundRule, error) {
+	properties := network.OutboundRulePropertiesFormat{
+		Protocol: network.TransportProtocol(d.Get("protocol").(string)),
+	}
+
+	beAP := d.Get("backend_address_pool_id").(string)
+	properties.BackendAddressPool = &network.SubResource{ID: &beAP}
+
+	if v, ok := d.GetOk("allocated_outbound_ports"); ok {
+		properties.AllocatedOutboundPorts = azure.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("idle_timeout_in_minutes"); ok {
+		properties.IdleTimeoutInMinutes = azure.Int32(int32(v.(int)))
+	}
+
+	feConfigNames := d.Get("frontend_ip_configuration_names").([]interface{})
+	feConfigs := make([]network.SubResource, 0, len(feConfigNames))
+	for _, raw := range feConfigNames {
+		name := raw.(string)
+		feip, _, exists := findLoadBalancerFrontEndIpConfigurationByName(lb, name)
+		if !exists {
+			return nil, fmt.Errorf("[ERROR] Cannot find FrontEnd IP Configuration with the name %s", name)
+		}
+
+		feConfigs = append(feConfigs, network.SubResource{ID: feip.ID})
+	}
+	properties.FrontendIPConfigurations = &feConfigs
+
+	outboundRule := network.OutboundRule{
+		Name:       azure.String(d.Get("name").(string)),
+		Properties: &properties,
+	}
+
+	return &outboundRule, nil
+}
+
+// findLoadBalancerOutboundRuleByName locates an OutboundRule on the given
+// Load Balancer by name, returning its index alongside it so callers can
+// splice it out of the backing slice.
+func findLoadBalancerOutboundRuleByName(lb *network.LoadBalancer, name string) (*network.OutboundRule, int, bool) {
+	if lb == nil || lb.Properties == nil || lb.Properties.OutboundRules == nil {
+		return nil, -1, false
+	}
+
+	for i, rule := range *lb.Properties.OutboundRules {
+		if rule.Name != nil && *rule.Name == name {
+			return &rule, i, true
+		}
+	}
+
+	return nil, -1, false
+}
@@ -0,0 +1,93 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/arm/network"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMLoadBalancerOutboundRule_basic(t *testing.T) {
+	var lb network.LoadBalancer
+	ri := acctest.RandInt()
+	outboundRuleName := fmt.Sprintf("OutboundRule-%d", ri)
+
+	resourceName := "azurerm_lb_outbound_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLoadBalancerOutboundRule_basic(ri, testLocation(), outboundRuleName),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLoadBalancerExists("azurerm_lb.test", &lb),
+					testCheckAzureRMLoadBalancerOutboundRuleExists(outboundRuleName, &lb),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "All"),
+					resource.TestCheckResourceAttr(resourceName, "allocated_outbound_ports", "1024"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMLoadBalancerOutboundRuleExists(outboundRuleName string, lb *network.LoadBalancer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, _, exists := findLoadBalancerOutboundRuleByName(lb, outboundRuleName)
+		if !exists {
+			return fmt.Errorf("An Outbound Rule with name %q cannot be found.", outboundRuleName)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMLoadBalancerOutboundRule_basic(rInt int, location string, outboundRuleName string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                         = "arm-test-ip-%d"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  sku                          = "Standard"
+  public_ip_address_allocation = "Static"
+}
+
+resource "azurerm_lb" "test" {
+  name                = "arm-test-loadbalancer-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+
+  frontend_ip_configuration {
+    name                 = "one-%d"
+    public_ip_address_id = "${azurerm_public_ip.test.id}"
+  }
+}
+
+resource "azurerm_lb_backend_address_pool" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  loadbalancer_id      = "${azurerm_lb.test.id}"
+  name                 = "be-%d"
+}
+
+resource "azurerm_lb_outbound_rule" "test" {
+  name                     = "%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  loadbalancer_id          = "${azurerm_lb.test.id}"
+  protocol                 = "All"
+  allocated_outbound_ports = 1024
+  backend_address_pool_id  = "${azurerm_lb_backend_address_pool.test.id}"
+
+  frontend_ip_configuration_names = ["one-%d"]
+}
+`, rInt, location, rInt, rInt, rInt, rInt, outboundRuleName, rInt)
+}
@@ -63,19 +63,27 @@ func resourceArmLoadBalancerRule() *schema.Resource {
 				Computed: true,
 			},
 
+			// protocol, frontend_port and backend_port are Optional/Computed
+			// rather than Required so that enable_ha_ports can drive them to
+			// "All"/0/0 without creating a perpetual diff against a config
+			// that simply omits them. expandAzureRmLoadBalancerRule still
+			// requires them to be set explicitly when enable_ha_ports is false.
 			"protocol": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 
 			"frontend_port": {
 				Type:     schema.TypeInt,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 
 			"backend_port": {
 				Type:     schema.TypeInt,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 
 			"probe_id": {
@@ -90,6 +98,12 @@ func resourceArmLoadBalancerRule() *schema.Resource {
 				Default:  false,
 			},
 
+			"enable_ha_ports": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"idle_timeout_in_minutes": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -258,14 +272,45 @@ func resourceArmLoadBalancerRuleDelete(d *schema.ResourceData, meta interface{})
 }
 
 func expandAzureRmLoadBalancerRule(d *schema.ResourceData, lb *network.LoadBalancer) (*network.LoadBalancingRule, error) {
+	enableHaPorts := d.Get("enable_ha_ports").(bool)
+	protocol := d.Get("protocol").(string)
+	frontendPort := d.Get("frontend_port").(int)
+	backendPort := d.Get("backend_port").(int)
+
+	if enableHaPorts {
+		if protocol != "" && protocol != "All" {
+			return nil, fmt.Errorf("LoadBalancer Rule %q: enable_ha_ports requires protocol to be unset or \"All\"", d.Get("name").(string))
+		}
+		if frontendPort != 0 || backendPort != 0 {
+			return nil, fmt.Errorf("LoadBalancer Rule %q: enable_ha_ports requires frontend_port and backend_port to be unset or 0", d.Get("name").(string))
+		}
+
+		protocol = "All"
+		frontendPort = 0
+		backendPort = 0
+	} else if protocol == "" {
+		return nil, fmt.Errorf("LoadBalancer Rule %q: protocol is required unless enable_ha_ports is set", d.Get("name").(string))
+	} else {
+		oldHaPorts, _ := d.GetChange("enable_ha_ports")
+		if oldHaPorts.(bool) && protocol == "All" && frontendPort == 0 && backendPort == 0 {
+			return nil, fmt.Errorf("LoadBalancer Rule %q: enable_ha_ports was disabled but protocol, frontend_port and backend_port still reflect the previous HA Ports configuration; set them explicitly", d.Get("name").(string))
+		}
+		if frontendPort == 0 || backendPort == 0 {
+			return nil, fmt.Errorf("LoadBalancer Rule %q: frontend_port and backend_port are required unless enable_ha_ports is set", d.Get("name").(string))
+		}
+	}
 
 	properties := network.LoadBalancingRulePropertiesFormat{
-		Protocol:         network.TransportProtocol(d.Get("protocol").(string)),
-		FrontendPort:     azure.Int32(int32(d.Get("frontend_port").(int))),
-		BackendPort:      azure.Int32(int32(d.Get("backend_port").(int))),
+		Protocol:         network.TransportProtocol(protocol),
+		FrontendPort:     azure.Int32(int32(frontendPort)),
+		BackendPort:      azure.Int32(int32(backendPort)),
 		EnableFloatingIP: azure.Bool(d.Get("enable_floating_ip").(bool)),
 	}
 
+	if properties.Protocol == "All" && loadBalancerSkuName(lb) != network.LoadBalancerSkuNameStandard {
+		return nil, fmt.Errorf("LoadBalancer Rule %q: HA Ports (protocol \"All\") is only supported on Standard SKU Load Balancers", d.Get("name").(string))
+	}
+
 	if v, ok := d.GetOk("idle_timeout_in_minutes"); ok {
 		properties.IdleTimeoutInMinutes = azure.Int32(int32(v.(int)))
 	}
@@ -274,6 +319,10 @@ func expandAzureRmLoadBalancerRule(d *schema.ResourceData, lb *network.LoadBalan
 		properties.LoadDistribution = network.LoadDistribution(v)
 	}
 
+	if *properties.EnableFloatingIP && properties.LoadDistribution == network.LoadDistribution("SourceIP") && loadBalancerSkuName(lb) == network.LoadBalancerSkuNameStandard {
+		return nil, fmt.Errorf("LoadBalancer Rule %q: enable_floating_ip cannot be combined with load_distribution \"SourceIP\" on Standard SKU Load Balancers", d.Get("name").(string))
+	}
+
 	if v := d.Get("frontend_ip_configuration_name").(string); v != "" {
 		rule, _, exists := findLoadBalancerFrontEndIpConfigurationByName(lb, v)
 		if !exists {
@@ -340,3 +389,13 @@ func validateArmLoadBalancerRuleName(v interface{}, k string) (ws []string, erro
 
 	return
 }
+
+// loadBalancerSkuName returns the SKU of the given Load Balancer, defaulting
+// to Basic since that's the SKU Azure assumes when none is set.
+func loadBalancerSkuName(lb *network.LoadBalancer) network.LoadBalancerSkuName {
+	if lb == nil || lb.Sku == nil {
+		return network.LoadBalancerSkuNameBasic
+	}
+
+	return lb.Sku.Name
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"reflect"
 	"sync"
 
 	"github.com/hashicorp/go-multierror"
@@ -185,6 +186,83 @@ func (p *shadowResourceProviderReal) Refresh(
 	return result, err
 }
 
+func (p *shadowResourceProviderReal) ImportState(
+	info *InstanceInfo, id string) ([]*InstanceState, error) {
+	result, err := p.ResourceProvider.ImportState(info, id)
+	p.Shared.ImportState.SetValue(info.HumanId(), &shadowResourceProviderImportState{
+		Id:        id,
+		Result:    result,
+		ResultErr: err,
+	})
+
+	return result, err
+}
+
+func (p *shadowResourceProviderReal) ValidateDataSource(
+	t string, c *ResourceConfig) ([]string, []error) {
+	key := t
+
+	// Real operation
+	warns, errs := p.ResourceProvider.ValidateDataSource(t, c)
+
+	// Get the result
+	raw, ok := p.Shared.ValidateDataSource.ValueOk(key)
+	if !ok {
+		raw = new(shadowResourceProviderValidateDataSourceWrapper)
+	}
+
+	wrapper, ok := raw.(*shadowResourceProviderValidateDataSourceWrapper)
+	if !ok {
+		// If this fails then we just continue with our day... the shadow
+		// will fail to but there isn't much we can do.
+		log.Printf(
+			"[ERROR] unknown value in ValidateDataSource shadow value: %#v", raw)
+		return warns, errs
+	}
+
+	// Lock the wrapper for writing and record our call
+	wrapper.Lock()
+	defer wrapper.Unlock()
+
+	wrapper.Calls = append(wrapper.Calls, &shadowResourceProviderValidateDataSource{
+		Config: c,
+		Warns:  warns,
+		Errors: errs,
+	})
+
+	// Set it
+	p.Shared.ValidateDataSource.SetValue(key, wrapper)
+
+	// Return the result
+	return warns, errs
+}
+
+func (p *shadowResourceProviderReal) ReadDataDiff(
+	info *InstanceInfo,
+	desired *ResourceConfig) (*InstanceDiff, error) {
+	result, err := p.ResourceProvider.ReadDataDiff(info, desired)
+	p.Shared.ReadDataDiff.SetValue(info.HumanId(), &shadowResourceProviderReadDataDiff{
+		Desired:   desired,
+		Result:    result,
+		ResultErr: err,
+	})
+
+	return result, err
+}
+
+func (p *shadowResourceProviderReal) ReadDataApply(
+	info *InstanceInfo,
+	d *InstanceDiff) (*InstanceState, error) {
+	result, err := p.ResourceProvider.ReadDataApply(info, d)
+	p.Shared.ReadDataApply.SetValue(info.HumanId(), &shadowResourceProviderReadDataApply{
+		Diff:      d,
+		Result:    result,
+		ResultErr: err,
+	})
+
+	return result, err
+}
+
 // shadowResourceProviderShadow is the shadow resource provider. Function
 // calls never affect real resources. This is paired with the "real" side
 // which must be called properly to enable recording.
@@ -203,21 +281,26 @@ type shadowResourceProviderShared struct {
 	// NOTE: Anytime a value is added here, be sure to add it to
 	// the Close() method so that it is closed.
 
-	CloseErr         shadow.Value
-	Input            shadow.Value
-	Validate         shadow.Value
-	Configure        shadow.Value
-	ValidateResource shadow.KeyedValue
-	Apply            shadow.KeyedValue
-	Diff             shadow.KeyedValue
-	Refresh          shadow.KeyedValue
+	CloseErr           shadow.Value
+	Input              shadow.Value
+	Validate           shadow.Value
+	Configure          shadow.Value
+	ValidateResource   shadow.KeyedValue
+	Apply              shadow.KeyedValue
+	Diff               shadow.KeyedValue
+	Refresh            shadow.KeyedValue
+	ImportState        shadow.KeyedValue
+	ValidateDataSource shadow.KeyedValue
+	ReadDataDiff       shadow.KeyedValue
+	ReadDataApply      shadow.KeyedValue
 }
 
 func (p *shadowResourceProviderShared) Close() error {
 	closers := []io.Closer{
 		&p.CloseErr, &p.Input, &p.Validate,
 		&p.Configure, &p.ValidateResource, &p.Apply, &p.Diff,
-		&p.Refresh,
+		&p.Refresh, &p.ImportState, &p.ValidateDataSource,
+		&p.ReadDataDiff, &p.ReadDataApply,
 	}
 
 	for _, c := range closers {
@@ -435,7 +518,13 @@ func (p *shadowResourceProviderShadow) Apply(
 		p.ErrorLock.Unlock()
 	}
 
-	// TODO: compare diffs
+	if !diff.Equal(result.Diff) {
+		p.ErrorLock.Lock()
+		p.Error = multierror.Append(p.Error, fmt.Errorf(
+			"Apply %q: diff had unequal diffs (real, then shadow):\n\n%#v\n\n%#v",
+			key, result.Diff, diff))
+		p.ErrorLock.Unlock()
+	}
 
 	return result.Result, result.ResultErr
 }
@@ -520,30 +609,161 @@ func (p *shadowResourceProviderShadow) Refresh(
 	return result.Result, result.ResultErr
 }
 
-// TODO
-// TODO
-// TODO
-// TODO
-// TODO
-
 func (p *shadowResourceProviderShadow) ImportState(info *InstanceInfo, id string) ([]*InstanceState, error) {
-	return nil, nil
+	// Unique key
+	key := info.HumanId()
+	raw := p.Shared.ImportState.Value(key)
+	if raw == nil {
+		p.ErrorLock.Lock()
+		defer p.ErrorLock.Unlock()
+		p.Error = multierror.Append(p.Error, fmt.Errorf(
+			"Unknown 'import state' call for %q: %s",
+			key, id))
+		return nil, nil
+	}
+
+	result, ok := raw.(*shadowResourceProviderImportState)
+	if !ok {
+		p.ErrorLock.Lock()
+		defer p.ErrorLock.Unlock()
+		p.Error = multierror.Append(p.Error, fmt.Errorf(
+			"Unknown 'import state' shadow value: %#v", raw))
+		return nil, nil
+	}
+
+	// Compare the parameters, which should be identical
+	if id != result.Id {
+		p.ErrorLock.Lock()
+		p.Error = multierror.Append(p.Error, fmt.Errorf(
+			"ImportState %q had unequal ids (real, then shadow):\n\n%#v\n\n%#v",
+			key, result.Id, id))
+		p.ErrorLock.Unlock()
+	}
+
+	return result.Result, result.ResultErr
 }
 
 func (p *shadowResourceProviderShadow) ValidateDataSource(t string, c *ResourceConfig) ([]string, []error) {
-	return nil, nil
+	// Unique key
+	key := t
+
+	// Get the initial value
+	raw := p.Shared.ValidateDataSource.Value(key)
+
+	// Find a validation with our configuration
+	var result *shadowResourceProviderValidateDataSource
+	for {
+		// Get the value
+		if raw == nil {
+			p.ErrorLock.Lock()
+			defer p.ErrorLock.Unlock()
+			p.Error = multierror.Append(p.Error, fmt.Errorf(
+				"Unknown 'ValidateDataSource' call for %q:\n\n%#v",
+				key, c))
+			return nil, nil
+		}
+
+		wrapper, ok := raw.(*shadowResourceProviderValidateDataSourceWrapper)
+		if !ok {
+			p.ErrorLock.Lock()
+			defer p.ErrorLock.Unlock()
+			p.Error = multierror.Append(p.Error, fmt.Errorf(
+				"Unknown 'ValidateDataSource' shadow value: %#v", raw))
+			return nil, nil
+		}
+
+		// Look for the matching call with our configuration
+		wrapper.RLock()
+		for _, call := range wrapper.Calls {
+			if call.Config.Equal(c) {
+				result = call
+				break
+			}
+		}
+		wrapper.RUnlock()
+
+		// If we found a result, exit
+		if result != nil {
+			break
+		}
+
+		// Wait for a change so we can get the wrapper again
+		raw = p.Shared.ValidateDataSource.WaitForChange(key)
+	}
+
+	return result.Warns, result.Errors
 }
 
 func (p *shadowResourceProviderShadow) ReadDataDiff(
 	info *InstanceInfo,
 	desired *ResourceConfig) (*InstanceDiff, error) {
-	return nil, nil
+	// Unique key
+	key := info.HumanId()
+	raw := p.Shared.ReadDataDiff.Value(key)
+	if raw == nil {
+		p.ErrorLock.Lock()
+		defer p.ErrorLock.Unlock()
+		p.Error = multierror.Append(p.Error, fmt.Errorf(
+			"Unknown 'read data diff' call for %q:\n\n%#v",
+			key, desired))
+		return nil, nil
+	}
+
+	result, ok := raw.(*shadowResourceProviderReadDataDiff)
+	if !ok {
+		p.ErrorLock.Lock()
+		defer p.ErrorLock.Unlock()
+		p.Error = multierror.Append(p.Error, fmt.Errorf(
+			"Unknown 'read data diff' shadow value: %#v", raw))
+		return nil, nil
+	}
+
+	// Compare the parameters, which should be identical
+	if !desired.Equal(result.Desired) {
+		p.ErrorLock.Lock()
+		p.Error = multierror.Append(p.Error, fmt.Errorf(
+			"ReadDataDiff %q had unequal configs (real, then shadow):\n\n%#v\n\n%#v",
+			key, result.Desired, desired))
+		p.ErrorLock.Unlock()
+	}
+
+	return result.Result, result.ResultErr
 }
 
 func (p *shadowResourceProviderShadow) ReadDataApply(
 	info *InstanceInfo,
 	d *InstanceDiff) (*InstanceState, error) {
-	return nil, nil
+	// Unique key
+	key := info.HumanId()
+	raw := p.Shared.ReadDataApply.Value(key)
+	if raw == nil {
+		p.ErrorLock.Lock()
+		defer p.ErrorLock.Unlock()
+		p.Error = multierror.Append(p.Error, fmt.Errorf(
+			"Unknown 'read data apply' call for %q:\n\n%#v",
+			key, d))
+		return nil, nil
+	}
+
+	result, ok := raw.(*shadowResourceProviderReadDataApply)
+	if !ok {
+		p.ErrorLock.Lock()
+		defer p.ErrorLock.Unlock()
+		p.Error = multierror.Append(p.Error, fmt.Errorf(
+			"Unknown 'read data apply' shadow value: %#v", raw))
+		return nil, nil
+	}
+
+	// Compare the parameters, which should be identical
+	if !d.Equal(result.Diff) {
+		p.ErrorLock.Lock()
+		p.Error = multierror.Append(p.Error, fmt.Errorf(
+			"ReadDataApply %q: diff had unequal diffs (real, then shadow):\n\n%#v\n\n%#v",
+			key, result.Diff, d))
+		p.ErrorLock.Unlock()
+	}
+
+	return result.Result, result.ResultErr
 }
 
 // The structs for the various function calls are put below. These structs
@@ -597,3 +817,87 @@ type shadowResourceProviderRefresh struct {
 	Result    *InstanceState
 	ResultErr error
 }
+
+type shadowResourceProviderImportState struct {
+	Id        string
+	Result    []*InstanceState
+	ResultErr error
+}
+
+type shadowResourceProviderValidateDataSourceWrapper struct {
+	sync.RWMutex
+
+	Calls []*shadowResourceProviderValidateDataSource
+}
+
+type shadowResourceProviderValidateDataSource struct {
+	Config *ResourceConfig
+	Warns  []string
+	Errors []error
+}
+
+type shadowResourceProviderReadDataDiff struct {
+	Desired   *ResourceConfig
+	Result    *InstanceDiff
+	ResultErr error
+}
+
+type shadowResourceProviderReadDataApply struct {
+	Diff      *InstanceDiff
+	Result    *InstanceState
+	ResultErr error
+}
+
+// Equal compares two diffs for structural equality. This is used by the
+// shadow graph to verify that the diff the shadow would've produced
+// matches the diff the real provider actually used during Apply.
+func (d *InstanceDiff) Equal(d2 *InstanceDiff) bool {
+	if (d == nil) != (d2 == nil) {
+		return false
+	}
+	if d == nil {
+		return true
+	}
+
+	if d.Destroy != d2.Destroy {
+		return false
+	}
+	if d.DestroyDeposed != d2.DestroyDeposed {
+		return false
+	}
+	if d.DestroyTainted != d2.DestroyTainted {
+		return false
+	}
+
+	if len(d.Attributes) != len(d2.Attributes) {
+		return false
+	}
+
+	for k, attr := range d.Attributes {
+		attr2, ok := d2.Attributes[k]
+		if !ok || !attr.Equal(attr2) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal compares two ResourceAttrDiffs for structural equality.
+func (d *ResourceAttrDiff) Equal(d2 *ResourceAttrDiff) bool {
+	if (d == nil) != (d2 == nil) {
+		return false
+	}
+	if d == nil {
+		return true
+	}
+
+	return d.Old == d2.Old &&
+		d.New == d2.New &&
+		d.NewComputed == d2.NewComputed &&
+		d.NewRemoved == d2.NewRemoved &&
+		d.RequiresNew == d2.RequiresNew &&
+		d.Sensitive == d2.Sensitive &&
+		d.Type == d2.Type &&
+		reflect.DeepEqual(d.NewExtra, d2.NewExtra)
+}